@@ -0,0 +1,109 @@
+// This is a template file showing integration testing against a real
+// Postgres instance spun up on demand with testcontainers-go, as a
+// replacement for hand-rolling a DSN and skipping when unreachable.
+package example
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	_ "github.com/lib/pq"
+	"github.com/stretchr/testify/suite"
+	"github.com/testcontainers/testcontainers-go"
+	"github.com/testcontainers/testcontainers-go/modules/postgres"
+	"github.com/testcontainers/testcontainers-go/wait"
+)
+
+const containerSchema = `
+CREATE TABLE IF NOT EXISTS users (
+	id         TEXT PRIMARY KEY,
+	name       TEXT NOT NULL,
+	email      TEXT NOT NULL,
+	created_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);`
+
+// ContainerIntegrationSuite demonstrates integration testing against a
+// Postgres container managed by testcontainers-go, instead of the
+// hardcoded-DSN IntegrationSuite in testify_suite_example.go. Each test runs in its own
+// transaction, rolled back in TearDownTest, rather than truncating tables.
+type ContainerIntegrationSuite struct {
+	suite.Suite
+	container *postgres.PostgresContainer
+	db        *sql.DB
+	tx        *sql.Tx
+}
+
+func (s *ContainerIntegrationSuite) SetupSuite() {
+	ctx := context.Background()
+
+	// testcontainers-go reads TESTCONTAINERS_RYUK_DISABLED itself; set it in
+	// CI environments that don't allow the reaper container's privileges.
+	container, err := postgres.Run(ctx, "postgres:16-alpine",
+		postgres.WithDatabase("test"),
+		postgres.WithUsername("test"),
+		postgres.WithPassword("test"),
+		testcontainers.WithWaitStrategy(wait.ForListeningPort("5432/tcp")),
+	)
+	s.Require().NoError(err)
+	s.container = container
+
+	dsn, err := container.ConnectionString(ctx, "sslmode=disable")
+	s.Require().NoError(err)
+
+	db, err := sql.Open("postgres", dsn)
+	s.Require().NoError(err)
+	s.Require().NoError(db.Ping())
+	s.db = db
+
+	_, err = s.db.Exec(containerSchema)
+	s.Require().NoError(err)
+}
+
+func (s *ContainerIntegrationSuite) TearDownSuite() {
+	if s.db != nil {
+		s.db.Close()
+	}
+	if s.container != nil {
+		s.Require().NoError(s.container.Terminate(context.Background()))
+	}
+}
+
+// SetupTest starts a transaction so each test sees an isolated view of the
+// schema, instead of the TRUNCATE-per-test approach used elsewhere.
+func (s *ContainerIntegrationSuite) SetupTest() {
+	tx, err := s.db.Begin()
+	s.Require().NoError(err)
+	s.tx = tx
+}
+
+// TearDownTest rolls the transaction back, discarding any writes the test made.
+func (s *ContainerIntegrationSuite) TearDownTest() {
+	s.Require().NoError(s.tx.Rollback())
+}
+
+func (s *ContainerIntegrationSuite) TestRepositoryAgainstContainer() {
+	repo := NewUserDBRepository(s.tx)
+	ctx := context.Background()
+
+	user := &User{
+		ID:        "123",
+		Name:      "Alice",
+		Email:     "alice@example.com",
+		CreatedAt: time.Date(2024, 1, 15, 9, 0, 0, 0, time.UTC),
+	}
+	s.Require().NoError(repo.Create(ctx, user))
+
+	got, err := repo.GetByID(ctx, "123")
+	s.Require().NoError(err)
+	s.Equal("Alice", got.Name)
+	s.Equal("alice@example.com", got.Email)
+}
+
+func TestContainerIntegrationSuite(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping container integration tests in short mode")
+	}
+	suite.Run(t, new(ContainerIntegrationSuite))
+}
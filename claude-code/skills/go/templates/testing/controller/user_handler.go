@@ -0,0 +1,126 @@
+// Package controller demonstrates testing an HTTP layer built on top of a
+// example.UserService, following the controller -> usecase -> repository
+// layering used elsewhere in the example templates.
+package controller
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	example "github.com/henrychong-ai/ai/claude-code/skills/go/templates/testing"
+)
+
+// UserHandler serves user resources on top of a UserService. It depends
+// only on the interface, never the concrete repository, so it can be
+// tested with a mock service in place of a real database.
+type UserHandler struct {
+	service example.UserService
+}
+
+func NewUserHandler(service example.UserService) *UserHandler {
+	return &UserHandler{service: service}
+}
+
+// GetUser handles GET /users?id=... and returns the user as JSON. The id is
+// read from the query string only.
+func (h *UserHandler) GetUser(w http.ResponseWriter, r *http.Request) {
+	id := r.URL.Query().Get("id")
+	if id == "" {
+		writeJSONError(w, http.StatusBadRequest, "id is required")
+		return
+	}
+
+	user, err := h.service.GetByID(r.Context(), id)
+	if err != nil {
+		if errors.Is(err, example.ErrNotFound) {
+			writeJSONError(w, http.StatusNotFound, "user not found")
+			return
+		}
+		writeJSONError(w, http.StatusInternalServerError, "internal error")
+		return
+	}
+
+	writeJSON(w, http.StatusOK, user)
+}
+
+// createUserRequest is the JSON body accepted by CreateUser.
+type createUserRequest struct {
+	Name  string `json:"name"`
+	Email string `json:"email"`
+}
+
+// CreateUser handles POST /users with a JSON body.
+func (h *UserHandler) CreateUser(w http.ResponseWriter, r *http.Request) {
+	var req createUserRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeJSONError(w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+	if req.Name == "" || req.Email == "" {
+		writeJSONError(w, http.StatusBadRequest, "name and email are required")
+		return
+	}
+
+	user, err := h.service.Create(r.Context(), req.Name, req.Email)
+	if err != nil {
+		writeJSONError(w, http.StatusInternalServerError, "internal error")
+		return
+	}
+
+	writeJSON(w, http.StatusCreated, user)
+}
+
+// LoginHandler authenticates a user by ID, demonstrating a handler that
+// shares the same UserService as UserHandler but decodes a differently
+// shaped JSON request (a login payload rather than a resource body).
+type LoginHandler struct {
+	service example.UserService
+}
+
+func NewLoginHandler(service example.UserService) *LoginHandler {
+	return &LoginHandler{service: service}
+}
+
+// loginRequest is the JSON body accepted by Login.
+type loginRequest struct {
+	ID string `json:"id"`
+}
+
+func (h *LoginHandler) Login(w http.ResponseWriter, r *http.Request) {
+	var req loginRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil || req.ID == "" {
+		writeJSONError(w, http.StatusBadRequest, "id is required")
+		return
+	}
+
+	user, err := h.service.GetByID(r.Context(), req.ID)
+	if err != nil {
+		if errors.Is(err, example.ErrNotFound) {
+			writeJSONError(w, http.StatusUnauthorized, "invalid credentials")
+			return
+		}
+		writeJSONError(w, http.StatusInternalServerError, "internal error")
+		return
+	}
+
+	writeJSON(w, http.StatusOK, user)
+}
+
+// =============================================================================
+// Response Helpers
+// =============================================================================
+
+type errorResponse struct {
+	Error string `json:"error"`
+}
+
+func writeJSON(w http.ResponseWriter, status int, body any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(body)
+}
+
+func writeJSONError(w http.ResponseWriter, status int, message string) {
+	writeJSON(w, status, errorResponse{Error: message})
+}
@@ -0,0 +1,219 @@
+package controller
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	example "github.com/henrychong-ai/ai/claude-code/skills/go/templates/testing"
+	mocks "github.com/henrychong-ai/ai/claude-code/skills/go/templates/testing/mocks"
+)
+
+// handRolledUserService is a minimal example.UserService wired directly to
+// a UserRepository. example.DefaultUserService exposes GetUser/CreateUser
+// rather than the GetByID/Create methods of example.UserService, so it
+// cannot be passed to the handlers directly.
+type handRolledUserService struct {
+	repo example.UserRepository
+}
+
+func (s *handRolledUserService) GetByID(ctx context.Context, id string) (*example.User, error) {
+	return s.repo.GetByID(ctx, id)
+}
+
+func (s *handRolledUserService) Create(ctx context.Context, name, email string) (*example.User, error) {
+	user := &example.User{Name: name, Email: email}
+	if err := s.repo.Create(ctx, user); err != nil {
+		return nil, err
+	}
+	return user, nil
+}
+
+// =============================================================================
+// Suite Definition
+// =============================================================================
+
+type UserHandlerSuite struct {
+	suite.Suite
+	mockRepo *mocks.UserRepository
+	handler  *UserHandler
+	login    *LoginHandler
+}
+
+func (s *UserHandlerSuite) SetupTest() {
+	s.mockRepo = mocks.NewUserRepository(s.T())
+	service := &handRolledUserService{repo: s.mockRepo}
+	s.handler = NewUserHandler(service)
+	s.login = NewLoginHandler(service)
+}
+
+func (s *UserHandlerSuite) TearDownTest() {
+	s.mockRepo.AssertExpectations(s.T())
+}
+
+// =============================================================================
+// GetUser
+// =============================================================================
+
+func (s *UserHandlerSuite) TestGetUser_Success() {
+	s.mockRepo.On("GetByID", mock.Anything, "123").
+		Return(&example.User{ID: "123", Name: "Alice", Email: "alice@example.com"}, nil)
+
+	req := httptest.NewRequest(http.MethodGet, "/users?id=123", nil)
+	rec := httptest.NewRecorder()
+
+	s.handler.GetUser(rec, req)
+
+	s.Equal(http.StatusOK, rec.Code)
+
+	var got example.User
+	s.Require().NoError(json.Unmarshal(rec.Body.Bytes(), &got))
+	s.Equal("Alice", got.Name)
+}
+
+func (s *UserHandlerSuite) TestGetUser_MissingID() {
+	req := httptest.NewRequest(http.MethodGet, "/users", nil)
+	rec := httptest.NewRecorder()
+
+	s.handler.GetUser(rec, req)
+
+	s.Equal(http.StatusBadRequest, rec.Code)
+}
+
+func (s *UserHandlerSuite) TestGetUser_NotFound() {
+	s.mockRepo.On("GetByID", mock.Anything, "nonexistent").
+		Return(nil, example.ErrNotFound)
+
+	req := httptest.NewRequest(http.MethodGet, "/users?id=nonexistent", nil)
+	rec := httptest.NewRecorder()
+
+	s.handler.GetUser(rec, req)
+
+	s.Equal(http.StatusNotFound, rec.Code)
+
+	var got errorResponse
+	s.Require().NoError(json.Unmarshal(rec.Body.Bytes(), &got))
+	s.Equal("user not found", got.Error)
+}
+
+func (s *UserHandlerSuite) TestGetUser_RepositoryError() {
+	s.mockRepo.On("GetByID", mock.Anything, "123").
+		Return(nil, errors.New("connection refused"))
+
+	req := httptest.NewRequest(http.MethodGet, "/users?id=123", nil)
+	rec := httptest.NewRecorder()
+
+	s.handler.GetUser(rec, req)
+
+	s.Equal(http.StatusInternalServerError, rec.Code)
+
+	var got errorResponse
+	s.Require().NoError(json.Unmarshal(rec.Body.Bytes(), &got))
+	s.Equal("internal error", got.Error)
+}
+
+// =============================================================================
+// CreateUser
+// =============================================================================
+
+func (s *UserHandlerSuite) TestCreateUser_Success() {
+	s.mockRepo.On("Create", mock.Anything, mock.AnythingOfType("*example.User")).
+		Return(nil)
+
+	body, err := json.Marshal(createUserRequest{Name: "Bob", Email: "bob@example.com"})
+	s.Require().NoError(err)
+
+	req := httptest.NewRequest(http.MethodPost, "/users", bytes.NewReader(body))
+	rec := httptest.NewRecorder()
+
+	s.handler.CreateUser(rec, req)
+
+	s.Equal(http.StatusCreated, rec.Code)
+}
+
+func (s *UserHandlerSuite) TestCreateUser_ValidationError() {
+	body, err := json.Marshal(createUserRequest{Name: "", Email: "bob@example.com"})
+	s.Require().NoError(err)
+
+	req := httptest.NewRequest(http.MethodPost, "/users", bytes.NewReader(body))
+	rec := httptest.NewRecorder()
+
+	s.handler.CreateUser(rec, req)
+
+	s.Equal(http.StatusBadRequest, rec.Code)
+}
+
+func (s *UserHandlerSuite) TestCreateUser_RepositoryError() {
+	s.mockRepo.On("Create", mock.Anything, mock.AnythingOfType("*example.User")).
+		Return(errors.New("constraint violation"))
+
+	body, err := json.Marshal(createUserRequest{Name: "Bob", Email: "bob@example.com"})
+	s.Require().NoError(err)
+
+	req := httptest.NewRequest(http.MethodPost, "/users", bytes.NewReader(body))
+	rec := httptest.NewRecorder()
+
+	s.handler.CreateUser(rec, req)
+
+	s.Equal(http.StatusInternalServerError, rec.Code)
+}
+
+// =============================================================================
+// Login
+// =============================================================================
+
+func (s *UserHandlerSuite) TestLogin_Success() {
+	s.mockRepo.On("GetByID", mock.Anything, "123").
+		Return(&example.User{ID: "123", Name: "Alice"}, nil)
+
+	body, err := json.Marshal(loginRequest{ID: "123"})
+	s.Require().NoError(err)
+
+	req := httptest.NewRequest(http.MethodPost, "/login", bytes.NewReader(body))
+	rec := httptest.NewRecorder()
+
+	s.login.Login(rec, req)
+
+	s.Equal(http.StatusOK, rec.Code)
+}
+
+func (s *UserHandlerSuite) TestLogin_InvalidCredentials() {
+	s.mockRepo.On("GetByID", mock.Anything, "unknown").
+		Return(nil, example.ErrNotFound)
+
+	body, err := json.Marshal(loginRequest{ID: "unknown"})
+	s.Require().NoError(err)
+
+	req := httptest.NewRequest(http.MethodPost, "/login", bytes.NewReader(body))
+	rec := httptest.NewRecorder()
+
+	s.login.Login(rec, req)
+
+	s.Equal(http.StatusUnauthorized, rec.Code)
+}
+
+func (s *UserHandlerSuite) TestLogin_RepositoryError() {
+	s.mockRepo.On("GetByID", mock.Anything, "123").
+		Return(nil, errors.New("connection refused"))
+
+	body, err := json.Marshal(loginRequest{ID: "123"})
+	s.Require().NoError(err)
+
+	req := httptest.NewRequest(http.MethodPost, "/login", bytes.NewReader(body))
+	rec := httptest.NewRecorder()
+
+	s.login.Login(rec, req)
+
+	s.Equal(http.StatusInternalServerError, rec.Code)
+}
+
+func TestUserHandlerSuite(t *testing.T) {
+	suite.Run(t, new(UserHandlerSuite))
+}
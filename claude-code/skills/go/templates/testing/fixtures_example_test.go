@@ -0,0 +1,70 @@
+// This is a template file showing JSON fixture loading and golden-file
+// assertions, as a reusable alternative to hand-rolling json.Unmarshal
+// calls in each test.
+package example
+
+import (
+	"encoding/json"
+	"flag"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+var updateGolden = flag.Bool("update", false, "update golden files instead of comparing against them")
+
+// =============================================================================
+// Fixture Helpers
+// =============================================================================
+
+// LoadFixture reads name from the package's testdata directory, e.g.
+// LoadFixture(t, "user.json") reads testdata/user.json.
+func LoadFixture(t *testing.T, name string) []byte {
+	t.Helper()
+	data, err := os.ReadFile(filepath.Join("testdata", name))
+	require.NoError(t, err)
+	return data
+}
+
+// AssertJSONEqual compares want and got as JSON documents, ignoring key
+// ordering and insignificant whitespace.
+func AssertJSONEqual(t *testing.T, want, got []byte) {
+	t.Helper()
+	require.JSONEq(t, string(want), string(got))
+}
+
+// AssertGolden compares got against testdata/name. Run the test binary
+// with -update to regenerate the golden file from got instead.
+func AssertGolden(t *testing.T, name string, got []byte) {
+	t.Helper()
+	path := filepath.Join("testdata", name)
+
+	if *updateGolden {
+		require.NoError(t, os.WriteFile(path, got, 0o644))
+		return
+	}
+
+	want, err := os.ReadFile(path)
+	require.NoError(t, err)
+	AssertJSONEqual(t, want, got)
+}
+
+// =============================================================================
+// Demonstration: Fixture -> Mutate -> Golden
+// =============================================================================
+
+func TestUserFixture_RoundTrip(t *testing.T) {
+	data := LoadFixture(t, "user.json")
+
+	var u User
+	require.NoError(t, json.Unmarshal(data, &u))
+
+	u.Name = "Updated Name"
+
+	got, err := json.MarshalIndent(&u, "", "  ")
+	require.NoError(t, err)
+
+	AssertGolden(t, "user.golden.json", got)
+}
@@ -0,0 +1,175 @@
+// This is a template file showing repository testing against a real
+// database/sql driver using go-sqlmock, as an alternative to the
+// mock-based UserServiceSuite in testify_suite_example_test.go.
+package example
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+	"time"
+
+	sqlmock "github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/suite"
+)
+
+var sampleCreatedAt = time.Date(2024, 1, 15, 9, 0, 0, 0, time.UTC)
+
+// =============================================================================
+// SQL Repository Under Test
+// =============================================================================
+
+// DBTX is satisfied by both *sql.DB and *sql.Tx, letting UserDBRepository
+// run against a pooled connection in production and a single transaction
+// in tests (see ContainerIntegrationSuite's transaction-per-test setup).
+type DBTX interface {
+	ExecContext(ctx context.Context, query string, args ...any) (sql.Result, error)
+	QueryRowContext(ctx context.Context, query string, args ...any) *sql.Row
+}
+
+// UserDBRepository is a UserRepository backed by database/sql. It is the
+// kind of type the mocked UserRepository in the other examples stands in
+// for; this suite
+// exercises it directly against a driver mock instead.
+type UserDBRepository struct {
+	db DBTX
+}
+
+func NewUserDBRepository(db DBTX) *UserDBRepository {
+	return &UserDBRepository{db: db}
+}
+
+func (r *UserDBRepository) GetByID(ctx context.Context, id string) (*User, error) {
+	row := r.db.QueryRowContext(ctx, `SELECT id, name, email, created_at FROM users WHERE id = $1`, id)
+
+	var u User
+	if err := row.Scan(&u.ID, &u.Name, &u.Email, &u.CreatedAt); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrNotFound
+		}
+		return nil, err
+	}
+	return &u, nil
+}
+
+func (r *UserDBRepository) Create(ctx context.Context, user *User) error {
+	_, err := r.db.ExecContext(ctx,
+		`INSERT INTO users (id, name, email, created_at) VALUES ($1, $2, $3, $4)`,
+		user.ID, user.Name, user.Email, user.CreatedAt)
+	return err
+}
+
+func (r *UserDBRepository) Update(ctx context.Context, user *User) error {
+	_, err := r.db.ExecContext(ctx,
+		`UPDATE users SET name = $1, email = $2 WHERE id = $3`,
+		user.Name, user.Email, user.ID)
+	return err
+}
+
+func (r *UserDBRepository) Delete(ctx context.Context, id string) error {
+	_, err := r.db.ExecContext(ctx, `DELETE FROM users WHERE id = $1`, id)
+	return err
+}
+
+// =============================================================================
+// Suite Definition
+// =============================================================================
+
+// UserDBRepositorySuite demonstrates repository testing against a mocked
+// SQL driver rather than a mocked UserRepository.
+type UserDBRepositorySuite struct {
+	suite.Suite
+	db   *sql.DB
+	mock sqlmock.Sqlmock
+	repo *UserDBRepository
+	ctx  context.Context
+}
+
+func (s *UserDBRepositorySuite) SetupTest() {
+	db, mock, err := sqlmock.New()
+	s.Require().NoError(err)
+
+	s.db = db
+	s.mock = mock
+	s.repo = NewUserDBRepository(db)
+	s.ctx = context.Background()
+}
+
+func (s *UserDBRepositorySuite) TearDownTest() {
+	s.NoError(s.mock.ExpectationsWereMet())
+	s.db.Close()
+}
+
+// =============================================================================
+// Test Methods
+// =============================================================================
+
+func (s *UserDBRepositorySuite) TestGetByID_Success() {
+	rows := sqlmock.NewRows([]string{"id", "name", "email", "created_at"}).
+		AddRow("123", "Alice", "alice@example.com", sampleCreatedAt)
+
+	s.mock.ExpectQuery(`SELECT id, name, email, created_at FROM users WHERE id = \$1`).
+		WithArgs("123").
+		WillReturnRows(rows)
+
+	user, err := s.repo.GetByID(s.ctx, "123")
+
+	s.NoError(err)
+	s.Equal("Alice", user.Name)
+	s.Equal("alice@example.com", user.Email)
+}
+
+func (s *UserDBRepositorySuite) TestGetByID_NotFound() {
+	s.mock.ExpectQuery(`SELECT id, name, email, created_at FROM users WHERE id = \$1`).
+		WithArgs("nonexistent").
+		WillReturnError(sql.ErrNoRows)
+
+	user, err := s.repo.GetByID(s.ctx, "nonexistent")
+
+	s.ErrorIs(err, ErrNotFound)
+	s.Nil(user)
+}
+
+func (s *UserDBRepositorySuite) TestCreate_Success() {
+	user := &User{ID: "123", Name: "Bob", Email: "bob@example.com", CreatedAt: sampleCreatedAt}
+
+	s.mock.ExpectExec(`INSERT INTO users`).
+		WithArgs(user.ID, user.Name, user.Email, user.CreatedAt).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+
+	s.NoError(s.repo.Create(s.ctx, user))
+}
+
+func (s *UserDBRepositorySuite) TestCreate_RepoError() {
+	user := &User{ID: "123", Name: "Bob", Email: "bob@example.com", CreatedAt: sampleCreatedAt}
+	repoErr := errors.New("constraint violation")
+
+	s.mock.ExpectExec(`INSERT INTO users`).
+		WithArgs(user.ID, user.Name, user.Email, user.CreatedAt).
+		WillReturnError(repoErr)
+
+	s.ErrorIs(s.repo.Create(s.ctx, user), repoErr)
+}
+
+func (s *UserDBRepositorySuite) TestUpdate_Success() {
+	user := &User{ID: "123", Name: "Bob", Email: "bob@newmail.com"}
+
+	s.mock.ExpectExec(`UPDATE users SET`).
+		WithArgs(user.Name, user.Email, user.ID).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	s.NoError(s.repo.Update(s.ctx, user))
+}
+
+func (s *UserDBRepositorySuite) TestDelete_Success() {
+	s.mock.ExpectExec(`DELETE FROM users WHERE id = \$1`).
+		WithArgs("123").
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	s.NoError(s.repo.Delete(s.ctx, "123"))
+}
+
+func TestUserDBRepositorySuite(t *testing.T) {
+	suite.Run(t, new(UserDBRepositorySuite))
+}
@@ -0,0 +1,200 @@
+// This is a template file showing test suite structure with setup/teardown,
+// consuming the mockery-generated UserRepository mock. It lives in the
+// external example_test package (not package example) because the
+// generated mocks package imports this package for its domain types;
+// an internal test file sharing the "example" import path would still
+// form example -> mocks -> example, which go vet/go test reject as an
+// import cycle regardless of the _test.go suffix. Only a separate
+// example_test package, depending on both example and mocks without
+// either of them depending back on it, avoids the cycle.
+package example_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+
+	example "github.com/henrychong-ai/ai/claude-code/skills/go/templates/testing"
+	mocks "github.com/henrychong-ai/ai/claude-code/skills/go/templates/testing/mocks"
+)
+
+// =============================================================================
+// Mock Definitions
+// =============================================================================
+
+// LegacyMockUserRepository is a hand-written mock implementation of
+// example.UserRepository, kept alongside the mockery-generated
+// mocks.UserRepository as a point of comparison: every time
+// example.UserRepository gains a method, this one has to be updated by
+// hand, while mocks.UserRepository is regenerated with `task mocks`.
+type LegacyMockUserRepository struct {
+	mock.Mock
+}
+
+func (m *LegacyMockUserRepository) GetByID(ctx context.Context, id string) (*example.User, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*example.User), args.Error(1)
+}
+
+func (m *LegacyMockUserRepository) Create(ctx context.Context, user *example.User) error {
+	args := m.Called(ctx, user)
+	return args.Error(0)
+}
+
+func (m *LegacyMockUserRepository) Update(ctx context.Context, user *example.User) error {
+	args := m.Called(ctx, user)
+	return args.Error(0)
+}
+
+func (m *LegacyMockUserRepository) Delete(ctx context.Context, id string) error {
+	args := m.Called(ctx, id)
+	return args.Error(0)
+}
+
+// =============================================================================
+// Test Suite Definition
+// =============================================================================
+
+// UserServiceSuite is a test suite for example.DefaultUserService
+type UserServiceSuite struct {
+	suite.Suite
+	mockRepo *mocks.UserRepository
+	service  *example.DefaultUserService
+	ctx      context.Context
+}
+
+// =============================================================================
+// Suite Setup and Teardown
+// =============================================================================
+
+// SetupSuite runs once before all tests in the suite
+func (s *UserServiceSuite) SetupSuite() {
+	// Initialize shared resources (e.g., test database connection)
+	s.ctx = context.Background()
+}
+
+// TearDownSuite runs once after all tests in the suite
+func (s *UserServiceSuite) TearDownSuite() {
+	// Cleanup shared resources
+}
+
+// SetupTest runs before each test
+func (s *UserServiceSuite) SetupTest() {
+	// Create fresh mock for each test
+	s.mockRepo = mocks.NewUserRepository(s.T())
+	s.service = example.NewDefaultUserService(s.mockRepo)
+}
+
+// TearDownTest runs after each test. mocks.NewUserRepository already
+// registers AssertExpectations via t.Cleanup, so this is redundant but
+// harmless, and keeps the suite obvious to a reader who hasn't seen the
+// generated constructor.
+func (s *UserServiceSuite) TearDownTest() {
+	s.mockRepo.AssertExpectations(s.T())
+}
+
+// =============================================================================
+// Test Methods
+// =============================================================================
+
+func (s *UserServiceSuite) TestGetUser_Success() {
+	// Arrange
+	expectedUser := &example.User{
+		ID:    "123",
+		Name:  "Alice",
+		Email: "alice@example.com",
+	}
+
+	s.mockRepo.On("GetByID", mock.Anything, "123").
+		Return(expectedUser, nil)
+
+	// Act
+	user, err := s.service.GetUser(s.ctx, "123")
+
+	// Assert
+	s.NoError(err)
+	s.NotNil(user)
+	s.Equal("Alice", user.Name)
+	s.Equal("alice@example.com", user.Email)
+}
+
+func (s *UserServiceSuite) TestGetUser_NotFound() {
+	// Arrange
+	s.mockRepo.On("GetByID", mock.Anything, "nonexistent").
+		Return(nil, example.ErrNotFound)
+
+	// Act
+	user, err := s.service.GetUser(s.ctx, "nonexistent")
+
+	// Assert
+	s.ErrorIs(err, example.ErrNotFound)
+	s.Nil(user)
+}
+
+func (s *UserServiceSuite) TestCreateUser_Success() {
+	// Arrange
+	s.mockRepo.On("Create", mock.Anything, mock.AnythingOfType("*example.User")).
+		Return(nil)
+
+	// Act
+	user, err := s.service.CreateUser(s.ctx, "Bob", "bob@example.com")
+
+	// Assert
+	s.NoError(err)
+	s.NotNil(user)
+	s.Equal("Bob", user.Name)
+	s.Equal("bob@example.com", user.Email)
+}
+
+func (s *UserServiceSuite) TestCreateUser_RepoError() {
+	// Arrange
+	repoErr := errors.New("database error")
+	s.mockRepo.On("Create", mock.Anything, mock.AnythingOfType("*example.User")).
+		Return(repoErr)
+
+	// Act
+	user, err := s.service.CreateUser(s.ctx, "Bob", "bob@example.com")
+
+	// Assert
+	s.Error(err)
+	s.Nil(user)
+}
+
+// =============================================================================
+// Run the Suite
+// =============================================================================
+
+func TestUserServiceSuite(t *testing.T) {
+	suite.Run(t, new(UserServiceSuite))
+}
+
+// =============================================================================
+// Legacy Mock Comparison
+// =============================================================================
+
+// TestGetUser_Success_LegacyMock runs the same case as
+// UserServiceSuite.TestGetUser_Success but against LegacyMockUserRepository,
+// for comparison. Both mocks satisfy example.UserRepository identically;
+// the difference only shows up when the interface changes and the legacy
+// one needs a manual edit that mocks.UserRepository gets for free from
+// `task mocks`.
+func TestGetUser_Success_LegacyMock(t *testing.T) {
+	mockRepo := new(LegacyMockUserRepository)
+	service := example.NewDefaultUserService(mockRepo)
+
+	expectedUser := &example.User{ID: "123", Name: "Alice", Email: "alice@example.com"}
+	mockRepo.On("GetByID", mock.Anything, "123").Return(expectedUser, nil)
+
+	user, err := service.GetUser(context.Background(), "123")
+
+	require.NoError(t, err)
+	require.Equal(t, "Alice", user.Name)
+	mockRepo.AssertExpectations(t)
+}